@@ -36,18 +36,55 @@ func (g *SimpleGreeter) Greet(name string) (string, error) {
     return fmt.Sprintf("%s, %s!", g.greeting, name), nil
 }
 
+// Notifier delivers a side-channel notification (e.g. a desktop toast or a
+// chime) alongside a greeting. SilentNotifier is the default no-op.
+type Notifier interface {
+    Notify(title, body string) error
+}
+
+// SilentNotifier discards every notification.
+type SilentNotifier struct{}
+
+// Notify implements Notifier by doing nothing.
+func (SilentNotifier) Notify(title, body string) error {
+    return nil
+}
+
+// Option configures optional behavior of greetToWriter.
+type Option func(*greetOptions)
+
+type greetOptions struct {
+    notifier Notifier
+}
+
+// WithNotifier makes greetToWriter notify n after every successful greeting.
+func WithNotifier(n Notifier) Option {
+    return func(o *greetOptions) {
+        o.notifier = n
+    }
+}
+
 // greetToWriter writes greeting to any io.Writer (testable)
-func greetToWriter(w io.Writer, greeter Greeter, name string) error {
+func greetToWriter(w io.Writer, greeter Greeter, name string, opts ...Option) error {
+    options := greetOptions{notifier: SilentNotifier{}}
+    for _, opt := range opts {
+        opt(&options)
+    }
+
     message, err := greeter.Greet(name)
     if err != nil {
         return fmt.Errorf("failed to create greeting: %w", err)
     }
-    
+
     _, err = fmt.Fprintln(w, message)
     if err != nil {
         return fmt.Errorf("failed to write greeting: %w", err)
     }
-    
+
+    if err := options.notifier.Notify("Greeting sent", message); err != nil {
+        return fmt.Errorf("failed to notify: %w", err)
+    }
+
     return nil
 }
 
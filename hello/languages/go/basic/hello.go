@@ -0,0 +1,18 @@
+package main
+
+import "fmt"
+
+// Hello World in Go
+// Idiomatic Go with proper package structure
+//
+// This is the minimal single-file example; main.go one directory up is the
+// comprehensive version and lives separately so the two don't collide as a
+// single buildable package (both declare func main).
+//
+// To run: go run basic/hello.go
+// To build: go build basic/hello.go
+
+func main() {
+	// Using fmt package for formatted output
+	fmt.Println("Hello, World!")
+}
\ No newline at end of file
@@ -0,0 +1,172 @@
+// Command greet-history queries and purges a greeting history store.
+//
+// Usage:
+//
+//	greet-history query [-since=...] [-name=...] [-locale=...] [-format=table|json|csv]
+//	greet-history purge -since=...
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"example.com/hello/languages/go/pkg/history"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: greet-history <query|purge> [flags]")
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "query":
+		err = runQuery(os.Args[2:])
+	case "purge":
+		err = runPurge(os.Args[2:])
+	default:
+		err = fmt.Errorf("unknown subcommand %q (want query or purge)", os.Args[1])
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "greet-history: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// storeFlags are the flags shared by both subcommands for locating the store.
+type storeFlags struct {
+	db string
+}
+
+func addStoreFlags(fs *flag.FlagSet) *storeFlags {
+	sf := &storeFlags{}
+	fs.StringVar(&sf.db, "db", "greet-history.db", "path to the SQLite history database")
+	return sf
+}
+
+func (sf *storeFlags) open(ctx context.Context) (*history.SQLiteStore, error) {
+	store, err := history.NewSQLiteStore(ctx, sf.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history store at %q: %w", sf.db, err)
+	}
+	return store, nil
+}
+
+func runQuery(args []string) error {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	sf := addStoreFlags(fs)
+	since := fs.String("since", "", "only include entries at or after this RFC3339 timestamp")
+	name := fs.String("name", "", "only include entries for this name")
+	locale := fs.String("locale", "", "only include entries for this locale")
+	format := fs.String("format", "table", "output format: table, json, or csv")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	filter, err := parseFilter(*since, *name, *locale)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	store, err := sf.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	entries, err := store.List(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	return printEntries(os.Stdout, entries, *format)
+}
+
+func runPurge(args []string) error {
+	fs := flag.NewFlagSet("purge", flag.ExitOnError)
+	sf := addStoreFlags(fs)
+	since := fs.String("since", "", "purge entries older than this RFC3339 timestamp (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *since == "" {
+		return fmt.Errorf("-since is required for purge")
+	}
+
+	before, err := time.Parse(time.RFC3339, *since)
+	if err != nil {
+		return fmt.Errorf("invalid -since %q: %w", *since, err)
+	}
+
+	ctx := context.Background()
+	store, err := sf.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if err := store.Purge(ctx, before); err != nil {
+		return fmt.Errorf("failed to purge entries: %w", err)
+	}
+	return nil
+}
+
+func parseFilter(since, name, locale string) (history.Filter, error) {
+	filter := history.Filter{Name: name, Locale: locale}
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return history.Filter{}, fmt.Errorf("invalid -since %q: %w", since, err)
+		}
+		filter.Since = t
+	}
+	return filter, nil
+}
+
+func printEntries(w *os.File, entries []history.Entry, format string) error {
+	switch format {
+	case "table":
+		fmt.Fprintf(w, "%-24s %-12s %-24s %-6s %-10s %s\n", "TIMESTAMP", "NAME", "GREETING", "LOCALE", "LATENCY", "ERROR")
+		for _, e := range entries {
+			fmt.Fprintf(w, "%-24s %-12s %-24s %-6s %-10s %s\n",
+				e.Timestamp.Format(time.RFC3339), e.Name, e.Greeting, e.Locale, e.Latency, e.Error)
+		}
+		return nil
+
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(entries); err != nil {
+			return fmt.Errorf("failed to encode entries as JSON: %w", err)
+		}
+		return nil
+
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"timestamp", "name", "greeting", "locale", "latency_ns", "error"}); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		for _, e := range entries {
+			row := []string{
+				e.Timestamp.Format(time.RFC3339), e.Name, e.Greeting, e.Locale,
+				strconv.FormatInt(e.Latency.Nanoseconds(), 10), e.Error,
+			}
+			if err := cw.Write(row); err != nil {
+				return fmt.Errorf("failed to write CSV row: %w", err)
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+
+	default:
+		return fmt.Errorf("unknown -format %q (want table, json, or csv)", format)
+	}
+}
@@ -0,0 +1,65 @@
+//go:build ignore
+
+// This file is a runnable example of a GreeterService client. It is excluded
+// from normal builds via the "ignore" build tag; run it with:
+//
+//	go run -tags ignore client_example.go
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"example.com/hello/languages/go/pkg/greeterpb"
+)
+
+func main() {
+	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to dial greeter-server: %v", err)
+	}
+	defer conn.Close()
+
+	client := greeterpb.NewGreeterServiceClient(conn)
+
+	// Unary example.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	reply, err := client.Greet(ctx, &greeterpb.HelloRequest{Name: "World"})
+	if err != nil {
+		log.Fatalf("Greet failed: %v", err)
+	}
+	log.Printf("Greet: %s (at %d)", reply.GetMessage(), reply.GetTimestamp())
+
+	// Streaming example.
+	stream, err := client.StreamGreet(context.Background())
+	if err != nil {
+		log.Fatalf("StreamGreet failed: %v", err)
+	}
+
+	for _, name := range []string{"Alice", "Bob", "Charlie"} {
+		if err := stream.Send(&greeterpb.HelloRequest{Name: name}); err != nil {
+			log.Fatalf("Send(%q) failed: %v", name, err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		log.Fatalf("CloseSend failed: %v", err)
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Recv failed: %v", err)
+		}
+		log.Printf("StreamGreet: %s", reply.GetMessage())
+	}
+}
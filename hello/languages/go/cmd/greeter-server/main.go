@@ -0,0 +1,101 @@
+// Command greeter-server runs the GreeterService over gRPC and, alongside
+// it, a grpc-gateway REST reverse proxy that speaks plain JSON over HTTP.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"example.com/hello/languages/go/pkg/greeterpb"
+	"example.com/hello/languages/go/pkg/greeterserver"
+)
+
+// simpleGreeter is the default Greeter used when the server is run standalone.
+type simpleGreeter struct {
+	prefix string
+}
+
+func (g simpleGreeter) Greet(name string) string {
+	return fmt.Sprintf("%s, %s!", g.prefix, name)
+}
+
+func main() {
+	var (
+		grpcAddr = flag.String("grpc-addr", ":50051", "address for the gRPC server")
+		httpAddr = flag.String("http-addr", ":8080", "address for the grpc-gateway REST proxy")
+		tlsCert  = flag.String("tls-cert", "", "path to a TLS certificate (enables TLS when set with -tls-key)")
+		tlsKey   = flag.String("tls-key", "", "path to a TLS private key (enables TLS when set with -tls-cert)")
+	)
+	flag.Parse()
+
+	srv := greeterserver.New(simpleGreeter{prefix: "Hello"})
+
+	grpcServer, err := newGRPCServer(*tlsCert, *tlsKey)
+	if err != nil {
+		log.Fatalf("failed to configure gRPC server: %v", err)
+	}
+	greeterpb.RegisterGreeterServiceServer(grpcServer, srv)
+
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *grpcAddr, err)
+	}
+
+	go func() {
+		log.Printf("gRPC server listening on %s", *grpcAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("gRPC server stopped: %v", err)
+		}
+	}()
+
+	if err := serveGateway(*grpcAddr, *httpAddr, *tlsCert != ""); err != nil {
+		log.Fatalf("gateway server stopped: %v", err)
+	}
+}
+
+// newGRPCServer builds a grpc.Server, enabling TLS when both cert and key are provided.
+func newGRPCServer(certFile, keyFile string) (*grpc.Server, error) {
+	if certFile == "" && keyFile == "" {
+		return grpc.NewServer(), nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("both -tls-cert and -tls-key must be set to enable TLS")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	creds := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})
+	return grpc.NewServer(grpc.Creds(creds)), nil
+}
+
+// serveGateway starts the grpc-gateway REST reverse proxy, dialing back to
+// the gRPC server running on grpcAddr.
+func serveGateway(grpcAddr, httpAddr string, tlsEnabled bool) error {
+	ctx := context.Background()
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if tlsEnabled {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))}
+	}
+
+	mux := runtime.NewServeMux()
+	if err := greeterpb.RegisterGreeterServiceHandlerFromEndpoint(ctx, mux, grpcAddr, dialOpts); err != nil {
+		return fmt.Errorf("failed to register gateway handler: %w", err)
+	}
+
+	log.Printf("REST gateway listening on %s", httpAddr)
+	return http.ListenAndServe(httpAddr, mux)
+}
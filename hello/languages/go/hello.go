@@ -1,14 +0,0 @@
-package main
-
-import "fmt"
-
-// Hello World in Go
-// Idiomatic Go with proper package structure
-//
-// To run: go run hello.go
-// To build: go build hello.go
-
-func main() {
-	// Using fmt package for formatted output
-	fmt.Println("Hello, World!")
-}
\ No newline at end of file
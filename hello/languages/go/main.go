@@ -11,6 +11,14 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/text/language"
+	"golang.org/x/time/rate"
+
+	"example.com/hello/languages/go/pkg/localize"
+	"example.com/hello/languages/go/pkg/notifier"
+	"example.com/hello/languages/go/pkg/observability"
 )
 
 // Greeter interface defines the contract for greeting behavior
@@ -18,14 +26,22 @@ type Greeter interface {
 	Greet(name string) string
 }
 
-// SimpleGreeter implements a basic greeting
+// SimpleGreeter implements the baseline English greeting, rendered from the
+// same locale catalog as greetLocalized rather than a hardcoded format
+// string, so the "Hello, %s!" text lives in exactly one place
+// (pkg/localize/locales/en.json).
 type SimpleGreeter struct {
-	prefix string
+	greeter *localize.LocalizedGreeter
 }
 
-// NewSimpleGreeter creates a new SimpleGreeter instance
-func NewSimpleGreeter(prefix string) *SimpleGreeter {
-	return &SimpleGreeter{prefix: prefix}
+// NewSimpleGreeter loads the locale catalog and returns a SimpleGreeter that
+// renders English greetings from it.
+func NewSimpleGreeter() (*SimpleGreeter, error) {
+	catalog, err := loadSharedCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load locale catalog: %w", err)
+	}
+	return &SimpleGreeter{greeter: localize.NewLocalizedGreeter(language.English, catalog)}, nil
 }
 
 // Greet returns a greeting message
@@ -33,35 +49,186 @@ func (g *SimpleGreeter) Greet(name string) string {
 	if name == "" {
 		name = "World"
 	}
-	return fmt.Sprintf("%s, %s!", g.prefix, name)
+	return g.greeter.Greet(name)
 }
 
-// AsyncGreeter demonstrates concurrent greeting with channels
+const (
+	// defaultWorkers is how many goroutines process greetings concurrently
+	// when WithWorkers is not supplied.
+	defaultWorkers = 8
+	// defaultQueueSize is the input channel buffer used when WithQueueSize
+	// is not supplied.
+	defaultQueueSize = 64
+)
+
+// Option configures optional behavior of an AsyncGreeter.
+type Option func(*AsyncGreeter)
+
+// WithNotifier makes the AsyncGreeter notify n after every greeting it produces.
+func WithNotifier(n notifier.Notifier) Option {
+	return func(ag *AsyncGreeter) {
+		ag.notifier = n
+	}
+}
+
+// WithWorkers sets the number of goroutines that process greetings concurrently.
+func WithWorkers(n int) Option {
+	return func(ag *AsyncGreeter) {
+		if n > 0 {
+			ag.workers = n
+		}
+	}
+}
+
+// WithRateLimit caps throughput to rps greetings per second, allowing bursts
+// up to burst, via a token-bucket limiter.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(ag *AsyncGreeter) {
+		ag.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// WithQueueSize sets the buffer size of the input channel GreetStream reads
+// from, bounding how far producers can run ahead of the worker pool.
+func WithQueueSize(n int) Option {
+	return func(ag *AsyncGreeter) {
+		if n > 0 {
+			ag.queueSize = n
+		}
+	}
+}
+
+// AsyncGreeter greets names concurrently through a bounded worker pool,
+// optionally rate-limited, so throughput stays constant-memory regardless of
+// how many names are greeted.
 type AsyncGreeter struct {
-	greeter Greeter
+	greeter   Greeter
+	notifier  notifier.Notifier
+	workers   int
+	queueSize int
+	limiter   *rate.Limiter
 }
 
 // NewAsyncGreeter creates a new AsyncGreeter
-func NewAsyncGreeter(greeter Greeter) *AsyncGreeter {
-	return &AsyncGreeter{greeter: greeter}
+func NewAsyncGreeter(greeter Greeter, opts ...Option) *AsyncGreeter {
+	ag := &AsyncGreeter{
+		greeter:   greeter,
+		notifier:  notifier.SilentNotifier{},
+		workers:   defaultWorkers,
+		queueSize: defaultQueueSize,
+	}
+	for _, opt := range opts {
+		opt(ag)
+	}
+	return ag
+}
+
+// Result is one name's outcome from GreetStream. Index preserves the
+// position the name arrived in on the input channel, since results may
+// complete out of order across workers.
+type Result struct {
+	Index   int
+	Name    string
+	Message string
+	Err     error
+}
+
+// GreetStream greets every name received on in using ag's worker pool,
+// emitting one Result per name on the returned channel. The channel closes
+// exactly once, after all in-flight work has drained, whether that's because
+// in closed or because ctx was cancelled. A per-item error (including
+// ctx.Err() for names that never got processed) does not abort the batch.
+func (ag *AsyncGreeter) GreetStream(ctx context.Context, in <-chan string) <-chan Result {
+	out := make(chan Result, ag.queueSize)
+
+	go func() {
+		defer close(out)
+
+		type indexed struct {
+			index int
+			name  string
+		}
+		work := make(chan indexed, ag.queueSize)
+
+		var wg sync.WaitGroup
+		for i := 0; i < ag.workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for item := range work {
+					res := ag.greetOne(ctx, item.index, item.name)
+					select {
+					case out <- res:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+
+		index := 0
+	feed:
+		for {
+			select {
+			case name, ok := <-in:
+				if !ok {
+					break feed
+				}
+				select {
+				case work <- indexed{index: index, name: name}:
+					index++
+				case <-ctx.Done():
+					out <- Result{Index: index, Name: name, Err: fmt.Errorf("greet cancelled: %w", ctx.Err())}
+					index++
+				}
+			case <-ctx.Done():
+				break feed
+			}
+		}
+		close(work)
+		wg.Wait()
+	}()
+
+	return out
+}
+
+// greetOne runs the rate limiter and the underlying Greeter for a single
+// name, notifying on success.
+func (ag *AsyncGreeter) greetOne(ctx context.Context, index int, name string) Result {
+	if ag.limiter != nil {
+		if err := ag.limiter.Wait(ctx); err != nil {
+			return Result{Index: index, Name: name, Err: fmt.Errorf("rate limit wait cancelled: %w", err)}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return Result{Index: index, Name: name, Err: fmt.Errorf("greet cancelled: %w", err)}
+	}
+
+	message := ag.greeter.Greet(name)
+	if err := ag.notifier.Notify(ctx, "Greeting sent", message); err != nil {
+		log.Printf("failed to notify for %q: %v", name, err)
+	}
+	return Result{Index: index, Name: name, Message: message}
 }
 
-// GreetConcurrently greets multiple names concurrently
+// GreetConcurrently greets multiple names through the worker pool and
+// returns their messages in input order. It is a convenience wrapper around
+// GreetStream for callers that already have the full name slice in memory.
 func (ag *AsyncGreeter) GreetConcurrently(names []string) []string {
+	in := make(chan string, len(names))
+	for _, name := range names {
+		in <- name
+	}
+	close(in)
+
 	results := make([]string, len(names))
-	var wg sync.WaitGroup
-	
-	for i, name := range names {
-		wg.Add(1)
-		go func(idx int, n string) {
-			defer wg.Done()
-			// Simulate some work
-			time.Sleep(100 * time.Millisecond)
-			results[idx] = ag.greeter.Greet(n)
-		}(i, name)
+	for res := range ag.GreetStream(context.Background(), in) {
+		if res.Err != nil {
+			log.Printf("failed to greet %q: %v", res.Name, res.Err)
+			continue
+		}
+		results[res.Index] = res.Message
 	}
-	
-	wg.Wait()
 	return results
 }
 
@@ -127,6 +294,63 @@ func doSomething() error {
 	return nil
 }
 
+// sharedCatalog is loaded once and reused by both SimpleGreeter and
+// greetLocalized, instead of re-parsing the embedded locale files on every
+// call.
+var (
+	sharedCatalogOnce sync.Once
+	sharedCatalog     *localize.Catalog
+	sharedCatalogErr  error
+)
+
+func loadSharedCatalog() (*localize.Catalog, error) {
+	sharedCatalogOnce.Do(func() {
+		sharedCatalog, sharedCatalogErr = localize.NewCatalog()
+	})
+	return sharedCatalog, sharedCatalogErr
+}
+
+// greetLocalized renders name's greeting in the given BCP-47 locale,
+// falling back through the tag's parent chain and finally to English.
+func greetLocalized(locale, name string) (string, error) {
+	if name == "" {
+		name = "World"
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", fmt.Errorf("invalid locale %q: %w", locale, err)
+	}
+
+	catalog, err := loadSharedCatalog()
+	if err != nil {
+		return "", fmt.Errorf("failed to load locale catalog: %w", err)
+	}
+
+	return localize.NewLocalizedGreeter(tag, catalog).Greet(name), nil
+}
+
+// buildNotifier assembles the notifier requested via -notify and -sound,
+// fanning out to both when both are enabled.
+func buildNotifier(notify bool, sound string) notifier.Notifier {
+	var notifiers []notifier.Notifier
+	if notify {
+		notifiers = append(notifiers, notifier.NewDesktopNotifier())
+	}
+	if sound != "" {
+		notifiers = append(notifiers, notifier.NewSoundNotifier(sound))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return notifier.SilentNotifier{}
+	case 1:
+		return notifiers[0]
+	default:
+		return notifier.NewMultiNotifier(notifiers...)
+	}
+}
+
 // main is the entry point of the program
 func main() {
 	// Command-line flags
@@ -135,23 +359,58 @@ func main() {
 		async     = flag.Bool("async", false, "Use async greeting")
 		showDemo  = flag.Bool("demo", false, "Show Go features demo")
 		timeout   = flag.Duration("timeout", 2*time.Second, "Timeout for context example")
+		notify    = flag.Bool("notify", false, "Show a desktop notification for each greeting")
+		sound     = flag.String("sound", "", "Play a chime for each greeting: a file path, or \"builtin\"")
+		locale    = flag.String("locale", "en", "BCP-47 locale for greetings, e.g. es, fr, ja, de, zh")
+		metricsAddr = flag.String("metrics-addr", "", "address to serve Prometheus /metrics on (disabled if empty)")
+		otlpEndpoint = flag.String("otlp-endpoint", "", "OTLP endpoint to export traces to (disabled if empty)")
 	)
 	flag.Parse()
-	
+
 	fmt.Println("=== Go Hello World ===")
-	
+
 	// Basic greeting
-	greeter := NewSimpleGreeter("Hello")
+	greeter, err := NewSimpleGreeter()
+	if err != nil {
+		log.Fatalf("failed to build greeter: %v", err)
+	}
 	fmt.Println(greeter.Greet(*name))
-	
+
 	// Interface polymorphism
 	var g Greeter = greeter
 	fmt.Println("Via interface:", g.Greet("Go Developer"))
-	
+
+	// Localized greeting
+	if localizedGreeting, err := greetLocalized(*locale, *name); err != nil {
+		log.Printf("Localized greeting error: %v", err)
+	} else {
+		fmt.Println("\nLocalized Greeting:")
+		fmt.Println(localizedGreeting)
+	}
+
 	// Async greeting
 	if *async {
 		fmt.Println("\nAsync Greetings:")
-		asyncGreeter := NewAsyncGreeter(greeter)
+
+		if *metricsAddr != "" {
+			if err := observability.ServeMetrics(*metricsAddr); err != nil {
+				log.Printf("failed to serve metrics: %v", err)
+			}
+		}
+		if *otlpEndpoint != "" {
+			shutdown, err := observability.ConfigureOTLPTracing(context.Background(), *otlpEndpoint)
+			if err != nil {
+				log.Printf("failed to configure OTLP tracing: %v", err)
+			} else {
+				defer shutdown(context.Background())
+			}
+		}
+
+		// SimpleGreeter -> TracedGreeter -> MeteredGreeter -> AsyncGreeter
+		traced := observability.NewTracedGreeter(greeter)
+		metered := observability.NewMeteredGreeter(traced, prometheus.DefaultRegisterer)
+
+		asyncGreeter := NewAsyncGreeter(metered, WithNotifier(buildNotifier(*notify, *sound)))
 		names := []string{"Alice", "Bob", "Charlie", "Diana"}
 		results := asyncGreeter.GreetConcurrently(names)
 		for _, result := range results {
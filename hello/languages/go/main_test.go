@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type echoGreeter struct{}
+
+func (echoGreeter) Greet(name string) string { return "Hello, " + name + "!" }
+
+func feed(names []string) <-chan string {
+	ch := make(chan string, len(names))
+	for _, n := range names {
+		ch <- n
+	}
+	close(ch)
+	return ch
+}
+
+func TestGreetConcurrentlyPreservesOrder(t *testing.T) {
+	ag := NewAsyncGreeter(echoGreeter{}, WithWorkers(4))
+
+	names := []string{"Alice", "Bob", "Charlie", "Diana"}
+	got := ag.GreetConcurrently(names)
+
+	for i, name := range names {
+		want := "Hello, " + name + "!"
+		if got[i] != want {
+			t.Errorf("results[%d] = %q, want %q", i, got[i], want)
+		}
+	}
+}
+
+func TestGreetStreamClosesExactlyOnceOnCancel(t *testing.T) {
+	ag := NewAsyncGreeter(echoGreeter{}, WithWorkers(2), WithQueueSize(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	out := ag.GreetStream(ctx, in)
+
+	go func() {
+		in <- "Alice"
+		cancel()
+		close(in)
+	}()
+
+	for range out {
+		// Drain until the channel closes; a second close would panic the
+		// sender goroutine and fail the test via a runtime panic.
+	}
+}
+
+func TestGreetStreamDoesNotLeakWorkersWhenCallerStopsReadingAfterCancel(t *testing.T) {
+	ag := NewAsyncGreeter(echoGreeter{}, WithWorkers(16), WithQueueSize(1))
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	ag.GreetStream(ctx, in)
+	cancel()
+	close(in)
+	// Deliberately never read from the returned channel: a caller who
+	// cancels and walks away must not leave worker goroutines blocked
+	// forever on a send nobody will ever receive.
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if runtime.NumGoroutine() <= before+1 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutine count stayed at %d (started at %d) 1s after cancel; workers appear leaked", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestGreetStreamReportsPerItemErrorsWithoutAbortingBatch(t *testing.T) {
+	ag := NewAsyncGreeter(echoGreeter{}, WithWorkers(2))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	names := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		names = append(names, strconv.Itoa(i))
+	}
+
+	count := 0
+	for range ag.GreetStream(ctx, feed(names)) {
+		count++
+	}
+	if count != len(names) {
+		t.Errorf("received %d results, want %d (one per input name, error or not)", count, len(names))
+	}
+}
+
+func BenchmarkGreetStream1M(b *testing.B) {
+	const n = 1_000_000
+	ag := NewAsyncGreeter(echoGreeter{}, WithWorkers(16), WithQueueSize(1024))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		in := make(chan string, 1024)
+		go func() {
+			defer close(in)
+			for j := 0; j < n; j++ {
+				in <- "benchmark-name"
+			}
+		}()
+
+		count := 0
+		for range ag.GreetStream(context.Background(), in) {
+			count++
+		}
+		if count != n {
+			b.Fatalf("received %d results, want %d", count, n)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package greeterpb
+
+// This file is hand-written, not generated: proto/greeter.proto carries no
+// google.api.http annotations (and we don't vendor the googleapis well-known
+// types just to get them), so there's nothing for protoc-gen-grpc-gateway to
+// generate from. It wires the one REST route cmd/greeter-server needs by
+// hand instead.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// RegisterGreeterServiceHandlerFromEndpoint dials endpoint and registers the
+// resulting client on mux, wiring up the REST routes by hand (see the
+// package doc comment above for why this isn't protoc-generated).
+func RegisterGreeterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	return RegisterGreeterServiceHandlerClient(ctx, mux, NewGreeterServiceClient(conn))
+}
+
+// RegisterGreeterServiceHandlerClient registers the REST routes for
+// GreeterService on mux, proxying each request to client.
+func RegisterGreeterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client GreeterServiceClient) error {
+	return mux.HandlePath(http.MethodPost, "/v1/greet", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req HelloRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		reply, err := client.Greet(r.Context(), &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(reply)
+	})
+}
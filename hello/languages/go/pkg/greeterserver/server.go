@@ -0,0 +1,113 @@
+// Package greeterserver adapts the Greeter interfaces from the comprehensive
+// main package to the generated greeterpb.GreeterService.
+package greeterserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"example.com/hello/languages/go/pkg/greeterpb"
+	"example.com/hello/languages/go/pkg/localize"
+)
+
+// Greeter mirrors the interface from the comprehensive main package so this
+// package does not have to import it directly (main is package main).
+type Greeter interface {
+	Greet(name string) string
+}
+
+// GRPCServer implements greeterpb.GreeterServiceServer on top of any Greeter.
+type GRPCServer struct {
+	greeterpb.UnimplementedGreeterServiceServer
+
+	greeter Greeter
+
+	catalogOnce sync.Once
+	catalog     *localize.Catalog
+	catalogErr  error
+}
+
+// New wraps greeter as a gRPC GreeterService.
+func New(greeter Greeter) *GRPCServer {
+	return &GRPCServer{greeter: greeter}
+}
+
+// localeCatalog lazily loads the locale catalog used to honor req.Locale,
+// shared across requests instead of reloading it on every call.
+func (s *GRPCServer) localeCatalog() (*localize.Catalog, error) {
+	s.catalogOnce.Do(func() {
+		s.catalog, s.catalogErr = localize.NewCatalog()
+	})
+	return s.catalog, s.catalogErr
+}
+
+// Greet renders a single greeting, honoring GreetingPrefix and Locale when
+// they're set on the request. Locale takes precedence: it selects the locale
+// catalog's own greeting text, which GreetingPrefix would otherwise override.
+func (s *GRPCServer) Greet(ctx context.Context, req *greeterpb.HelloRequest) (*greeterpb.HelloReply, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("greet cancelled: %w", err)
+	}
+
+	name := req.GetName()
+	if name == "" {
+		name = "World"
+	}
+
+	var message string
+	if locale := req.GetLocale(); locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return nil, fmt.Errorf("invalid locale %q: %w", locale, err)
+		}
+		catalog, err := s.localeCatalog()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load locale catalog: %w", err)
+		}
+		message = localize.NewLocalizedGreeter(tag, catalog).Greet(name)
+	} else {
+		message = s.greeter.Greet(name)
+		if prefix := req.GetGreetingPrefix(); prefix != "" {
+			message = fmt.Sprintf("%s, %s!", prefix, name)
+		}
+	}
+
+	return &greeterpb.HelloReply{
+		Message:   message,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+// StreamGreet echoes a HelloReply back for every HelloRequest the client
+// sends, until the client half-closes the stream or the context is cancelled.
+func (s *GRPCServer) StreamGreet(stream greeterpb.GreeterService_StreamGreetServer) error {
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stream cancelled: %w", ctx.Err())
+		default:
+		}
+
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("recv failed: %w", err)
+		}
+
+		reply, err := s.Greet(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(reply); err != nil {
+			return fmt.Errorf("send failed: %w", err)
+		}
+	}
+}
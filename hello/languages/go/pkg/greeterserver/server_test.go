@@ -0,0 +1,121 @@
+package greeterserver
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"example.com/hello/languages/go/pkg/greeterpb"
+)
+
+type staticGreeter struct{ prefix string }
+
+func (g staticGreeter) Greet(name string) string {
+	return g.prefix + ", " + name + "!"
+}
+
+// dialInProcess starts a GreeterService backed by a bufconn listener and
+// returns a client dialed against it, so tests never touch the network.
+func dialInProcess(t *testing.T, srv *GRPCServer) greeterpb.GreeterServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	greeterpb.RegisterGreeterServiceServer(grpcServer, srv)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			t.Logf("in-process server stopped: %v", err)
+		}
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial in-process server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return greeterpb.NewGreeterServiceClient(conn)
+}
+
+func TestGRPCServerGreet(t *testing.T) {
+	client := dialInProcess(t, New(staticGreeter{prefix: "Hello"}))
+
+	reply, err := client.Greet(context.Background(), &greeterpb.HelloRequest{Name: "Ada"})
+	if err != nil {
+		t.Fatalf("Greet returned error: %v", err)
+	}
+	if want := "Hello, Ada!"; reply.GetMessage() != want {
+		t.Errorf("Greet message = %q, want %q", reply.GetMessage(), want)
+	}
+	if reply.GetTimestamp() == 0 {
+		t.Error("Greet timestamp = 0, want a populated unix time")
+	}
+}
+
+func TestGRPCServerGreetHonorsLocale(t *testing.T) {
+	client := dialInProcess(t, New(staticGreeter{prefix: "Hello"}))
+
+	reply, err := client.Greet(context.Background(), &greeterpb.HelloRequest{Name: "Ada", Locale: "es"})
+	if err != nil {
+		t.Fatalf("Greet returned error: %v", err)
+	}
+	if want := "¡Hola, Ada!"; reply.GetMessage() != want {
+		t.Errorf("Greet message = %q, want %q", reply.GetMessage(), want)
+	}
+}
+
+func TestGRPCServerGreetRejectsInvalidLocale(t *testing.T) {
+	client := dialInProcess(t, New(staticGreeter{prefix: "Hello"}))
+
+	if _, err := client.Greet(context.Background(), &greeterpb.HelloRequest{Name: "Ada", Locale: "not-a-tag!!"}); err == nil {
+		t.Error("Greet with an invalid locale = nil error, want non-nil")
+	}
+}
+
+func TestGRPCServerGreetContextCancelled(t *testing.T) {
+	client := dialInProcess(t, New(staticGreeter{prefix: "Hello"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.Greet(ctx, &greeterpb.HelloRequest{Name: "Ada"}); err == nil {
+		t.Error("Greet with cancelled context = nil error, want non-nil")
+	}
+}
+
+func TestGRPCServerStreamGreet(t *testing.T) {
+	client := dialInProcess(t, New(staticGreeter{prefix: "Hi"}))
+
+	stream, err := client.StreamGreet(context.Background())
+	if err != nil {
+		t.Fatalf("StreamGreet returned error: %v", err)
+	}
+
+	names := []string{"Alice", "Bob"}
+	for _, name := range names {
+		if err := stream.Send(&greeterpb.HelloRequest{Name: name}); err != nil {
+			t.Fatalf("Send(%q) returned error: %v", name, err)
+		}
+		reply, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv after sending %q returned error: %v", name, err)
+		}
+		if want := "Hi, " + name + "!"; reply.GetMessage() != want {
+			t.Errorf("Recv message = %q, want %q", reply.GetMessage(), want)
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend returned error: %v", err)
+	}
+}
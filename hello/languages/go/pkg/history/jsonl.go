@@ -0,0 +1,193 @@
+package history
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// JSONLStore appends one JSON object per line to a file, rotating it once it
+// exceeds MaxBytes.
+type JSONLStore struct {
+	mu       sync.Mutex
+	path     string
+	maxBytes int64
+	file     *os.File
+}
+
+// NewJSONLStore opens (or creates) path for appending, rotating to
+// path+".1" once the file exceeds maxBytes. A maxBytes of 0 disables rotation.
+func NewJSONLStore(path string, maxBytes int64) (*JSONLStore, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+	return &JSONLStore{path: path, maxBytes: maxBytes, file: f}, nil
+}
+
+// Close closes the underlying file.
+func (s *JSONLStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// Append implements Store, rotating the file first if it has grown past maxBytes.
+func (s *JSONLStore) Append(ctx context.Context, e Entry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.rotateIfNeededLocked(); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("failed to append entry: %w", err)
+	}
+	return nil
+}
+
+// rotateIfNeededLocked renames the current file to path+".1" (overwriting any
+// previous rotation) and opens a fresh file, once maxBytes is exceeded.
+// Callers must hold s.mu.
+func (s *JSONLStore) rotateIfNeededLocked() error {
+	if s.maxBytes <= 0 {
+		return nil
+	}
+
+	info, err := s.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %q: %w", s.path, err)
+	}
+	if info.Size() < s.maxBytes {
+		return nil
+	}
+
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close %q before rotation: %w", s.path, err)
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return fmt.Errorf("failed to rotate %q: %w", s.path, err)
+	}
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to reopen %q after rotation: %w", s.path, err)
+	}
+	s.file = f
+	return nil
+}
+
+// List implements Store by scanning the current file plus its most recent rotation.
+func (s *JSONLStore) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []Entry
+	for _, path := range []string{s.path + ".1", s.path} {
+		entries, err := readJSONLFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if filter.matches(e) {
+				out = append(out, e)
+			}
+		}
+	}
+	return out, nil
+}
+
+// Purge implements Store by rewriting the current file without entries older than before.
+func (s *JSONLStore) Purge(ctx context.Context, before time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := readJSONLFile(s.path)
+	if err != nil {
+		return err
+	}
+
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close %q before purge: %w", s.path, err)
+	}
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to truncate %q for purge: %w", s.path, err)
+	}
+
+	w := bufio.NewWriter(f)
+	for _, e := range entries {
+		if e.Timestamp.Before(before) {
+			continue
+		}
+		line, err := json.Marshal(e)
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("failed to marshal entry during purge: %w", err)
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to write entry during purge: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to flush purged file: %w", err)
+	}
+
+	s.file = f
+	return nil
+}
+
+func readJSONLFile(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("failed to parse line in %q: %w", path, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan %q: %w", path, err)
+	}
+	return entries, nil
+}
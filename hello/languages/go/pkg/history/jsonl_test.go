@@ -0,0 +1,92 @@
+package history
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestJSONLStoreAppendAndList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := NewJSONLStore(path, 0)
+	if err != nil {
+		t.Fatalf("NewJSONLStore returned error: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	want := Entry{Timestamp: time.Now().Round(0), Name: "Ada", Greeting: "Hello, Ada!", Locale: "en"}
+	if err := s.Append(ctx, want); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+
+	entries, err := s.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name != want.Name || entries[0].Greeting != want.Greeting {
+		t.Errorf("entries = %+v, want one entry matching %+v", entries, want)
+	}
+}
+
+func TestJSONLStoreRotatesPastMaxBytes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := NewJSONLStore(path, 1)
+	if err != nil {
+		t.Fatalf("NewJSONLStore returned error: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if err := s.Append(ctx, Entry{Timestamp: time.Now(), Name: "Ada"}); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	entries, err := s.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) == 0 {
+		t.Error("expected rotated-plus-current entries to still be readable via List")
+	}
+}
+
+func TestJSONLStoreListSafeConcurrentWithPurge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := NewJSONLStore(path, 0)
+	if err != nil {
+		t.Fatalf("NewJSONLStore returned error: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		if err := s.Append(ctx, Entry{Timestamp: time.Now(), Name: "Ada"}); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			if _, err := s.List(ctx, Filter{}); err != nil {
+				t.Errorf("List returned error: %v", err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			if err := s.Purge(ctx, time.Now().Add(-time.Hour)); err != nil {
+				t.Errorf("Purge returned error: %v", err)
+			}
+		}
+	}()
+	wg.Wait()
+}
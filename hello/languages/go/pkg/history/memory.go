@@ -0,0 +1,88 @@
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a fixed-capacity ring buffer Store. Once full, each Append
+// overwrites the oldest entry, so memory use stays bounded regardless of how
+// long the process runs.
+type MemoryStore struct {
+	mu       sync.Mutex
+	entries  []Entry
+	capacity int
+	next     int
+	size     int
+}
+
+// NewMemoryStore returns a Store that keeps the most recent capacity entries.
+func NewMemoryStore(capacity int) *MemoryStore {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &MemoryStore{entries: make([]Entry, capacity), capacity: capacity}
+}
+
+// Append implements Store.
+func (m *MemoryStore) Append(ctx context.Context, e Entry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[m.next] = e
+	m.next = (m.next + 1) % m.capacity
+	if m.size < m.capacity {
+		m.size++
+	}
+	return nil
+}
+
+// List implements Store, returning matching entries oldest first.
+func (m *MemoryStore) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []Entry
+	start := (m.next - m.size + m.capacity) % m.capacity
+	for i := 0; i < m.size; i++ {
+		e := m.entries[(start+i)%m.capacity]
+		if filter.matches(e) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// Purge implements Store by dropping entries older than before.
+func (m *MemoryStore) Purge(ctx context.Context, before time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	start := (m.next - m.size + m.capacity) % m.capacity
+	kept := make([]Entry, 0, m.size)
+	for i := 0; i < m.size; i++ {
+		e := m.entries[(start+i)%m.capacity]
+		if !e.Timestamp.Before(before) {
+			kept = append(kept, e)
+		}
+	}
+
+	m.entries = make([]Entry, m.capacity)
+	copy(m.entries, kept)
+	m.size = len(kept)
+	m.next = m.size % m.capacity
+	return nil
+}
@@ -0,0 +1,109 @@
+package history
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreListReturnsOldestFirst(t *testing.T) {
+	s := NewMemoryStore(10)
+	ctx := context.Background()
+
+	base := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := s.Append(ctx, Entry{Timestamp: base.Add(time.Duration(i) * time.Second), Name: "Ada"}); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	entries, err := s.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("len(entries) = %d, want 3", len(entries))
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Timestamp.Before(entries[i-1].Timestamp) {
+			t.Errorf("entries not sorted oldest-first at index %d", i)
+		}
+	}
+}
+
+func TestMemoryStoreOverwritesOldestWhenFull(t *testing.T) {
+	s := NewMemoryStore(2)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		name := string(rune('A' + i))
+		if err := s.Append(ctx, Entry{Timestamp: time.Now(), Name: name}); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	entries, err := s.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2 (capacity)", len(entries))
+	}
+	if entries[0].Name != "B" || entries[1].Name != "C" {
+		t.Errorf("entries = %+v, want B then C (A overwritten)", entries)
+	}
+}
+
+func TestMemoryStorePurgeDropsOldEntries(t *testing.T) {
+	s := NewMemoryStore(10)
+	ctx := context.Background()
+	cutoff := time.Now()
+
+	if err := s.Append(ctx, Entry{Timestamp: cutoff.Add(-time.Hour), Name: "old"}); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if err := s.Append(ctx, Entry{Timestamp: cutoff.Add(time.Hour), Name: "new"}); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+
+	if err := s.Purge(ctx, cutoff); err != nil {
+		t.Fatalf("Purge returned error: %v", err)
+	}
+
+	entries, err := s.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name != "new" {
+		t.Errorf("entries after purge = %+v, want only %q", entries, "new")
+	}
+}
+
+func TestMemoryStoreAppendSafeUnderConcurrency(t *testing.T) {
+	s := NewMemoryStore(1000)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	const workers, perWorker = 20, 50
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				if err := s.Append(ctx, Entry{Timestamp: time.Now(), Name: "concurrent"}); err != nil {
+					t.Errorf("Append returned error: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	entries, err := s.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != workers*perWorker {
+		t.Errorf("len(entries) = %d, want %d", len(entries), workers*perWorker)
+	}
+}
@@ -0,0 +1,47 @@
+package history
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Greeter is the minimal interface RecordingGreeter wraps, mirroring the
+// comprehensive main's Greeter so this package doesn't import package main.
+type Greeter interface {
+	Greet(name string) string
+}
+
+// RecordingGreeter wraps a Greeter, appending an Entry to a Store for every
+// call. It is safe for concurrent use as long as the underlying Store is
+// (MemoryStore, JSONLStore, and SQLiteStore all are), which is what lets it
+// sit behind the worker pool from AsyncGreeter.
+type RecordingGreeter struct {
+	next   Greeter
+	store  Store
+	locale string
+}
+
+// NewRecordingGreeter wraps next, recording every greeting to store under locale.
+func NewRecordingGreeter(next Greeter, store Store, locale string) *RecordingGreeter {
+	return &RecordingGreeter{next: next, store: store, locale: locale}
+}
+
+// Greet records an Entry for the call then returns the wrapped Greeter's message.
+func (r *RecordingGreeter) Greet(name string) string {
+	start := time.Now()
+	message := r.next.Greet(name)
+	latency := time.Since(start)
+
+	entry := Entry{
+		Timestamp: start,
+		Name:      name,
+		Greeting:  message,
+		Locale:    r.locale,
+		Latency:   latency,
+	}
+	if err := r.store.Append(context.Background(), entry); err != nil {
+		log.Printf("failed to record greeting for %q: %v", name, err)
+	}
+	return message
+}
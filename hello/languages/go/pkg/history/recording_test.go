@@ -0,0 +1,57 @@
+package history
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+type echoGreeter struct{}
+
+func (echoGreeter) Greet(name string) string { return "Hello, " + name + "!" }
+
+func TestRecordingGreeterRecordsEachCall(t *testing.T) {
+	store := NewMemoryStore(10)
+	rg := NewRecordingGreeter(echoGreeter{}, store, "en")
+
+	if got := rg.Greet("Ada"); got != "Hello, Ada!" {
+		t.Errorf("Greet() = %q, want %q", got, "Hello, Ada!")
+	}
+
+	entries, err := store.List(context.Background(), Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name != "Ada" || entries[0].Greeting != "Hello, Ada!" {
+		t.Errorf("entries = %+v, want one entry for Ada", entries)
+	}
+}
+
+// TestRecordingGreeterSafeUnderWorkerPool mimics AsyncGreeter's worker pool
+// calling Greet from many goroutines at once, proving Append stays
+// safe when RecordingGreeter sits behind it.
+func TestRecordingGreeterSafeUnderWorkerPool(t *testing.T) {
+	store := NewMemoryStore(1000)
+	rg := NewRecordingGreeter(echoGreeter{}, store, "en")
+
+	const workers, perWorker = 16, 50
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				rg.Greet("Worker")
+			}
+		}()
+	}
+	wg.Wait()
+
+	entries, err := store.List(context.Background(), Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != workers*perWorker {
+		t.Errorf("len(entries) = %d, want %d", len(entries), workers*perWorker)
+	}
+}
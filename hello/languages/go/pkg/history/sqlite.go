@@ -0,0 +1,139 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// schemaMigrations lists schema changes in order, applied once each via the
+// schema_migrations tracking table. Append new migrations rather than
+// editing existing ones, so already-deployed databases keep working.
+var schemaMigrations = []string{
+	`CREATE TABLE IF NOT EXISTS entries (
+		id        INTEGER PRIMARY KEY AUTOINCREMENT,
+		timestamp INTEGER NOT NULL,
+		name      TEXT NOT NULL,
+		greeting  TEXT NOT NULL,
+		locale    TEXT NOT NULL,
+		latency_ns INTEGER NOT NULL,
+		error     TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS entries_timestamp_idx ON entries(timestamp)`,
+	`CREATE INDEX IF NOT EXISTS entries_name_idx ON entries(name)`,
+}
+
+// SQLiteStore is a Store backed by a CGO-free modernc.org/sqlite database.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) the database at path and
+// applies any pending schema migrations.
+func NewSQLiteStore(ctx context.Context, path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+
+	s := &SQLiteStore{db: db}
+	if err := s.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the underlying database.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLiteStore) migrate(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	for version, stmt := range schemaMigrations {
+		var applied int
+		err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, version).Scan(&applied)
+		if err != nil {
+			return fmt.Errorf("failed to check migration %d: %w", version, err)
+		}
+		if applied > 0 {
+			continue
+		}
+
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to apply migration %d: %w", version, err)
+		}
+		if _, err := s.db.ExecContext(ctx, `INSERT INTO schema_migrations(version) VALUES (?)`, version); err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// Append implements Store.
+func (s *SQLiteStore) Append(ctx context.Context, e Entry) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO entries(timestamp, name, greeting, locale, latency_ns, error) VALUES (?, ?, ?, ?, ?, ?)`,
+		e.Timestamp.UnixNano(), e.Name, e.Greeting, e.Locale, e.Latency.Nanoseconds(), e.Error,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append entry: %w", err)
+	}
+	return nil
+}
+
+// List implements Store, returning matching entries oldest first.
+func (s *SQLiteStore) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	query := `SELECT timestamp, name, greeting, locale, latency_ns, error FROM entries WHERE timestamp >= ?`
+	args := []interface{}{filter.Since.UnixNano()}
+
+	if filter.Name != "" {
+		query += ` AND name = ?`
+		args = append(args, filter.Name)
+	}
+	if filter.Locale != "" {
+		query += ` AND locale = ?`
+		args = append(args, filter.Locale)
+	}
+	query += ` ORDER BY timestamp ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entries: %w", err)
+	}
+	defer rows.Close()
+
+	var out []Entry
+	for rows.Next() {
+		var (
+			e         Entry
+			timestamp int64
+			latencyNs int64
+		)
+		if err := rows.Scan(&timestamp, &e.Name, &e.Greeting, &e.Locale, &latencyNs, &e.Error); err != nil {
+			return nil, fmt.Errorf("failed to scan entry: %w", err)
+		}
+		e.Timestamp = time.Unix(0, timestamp)
+		e.Latency = time.Duration(latencyNs)
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate entries: %w", err)
+	}
+	return out, nil
+}
+
+// Purge implements Store.
+func (s *SQLiteStore) Purge(ctx context.Context, before time.Time) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM entries WHERE timestamp < ?`, before.UnixNano()); err != nil {
+		return fmt.Errorf("failed to purge entries: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package history
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteStoreAppendListPurge(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history.db")
+
+	s, err := NewSQLiteStore(ctx, path)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore returned error: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	cutoff := time.Now()
+	if err := s.Append(ctx, Entry{Timestamp: cutoff.Add(-time.Hour), Name: "old", Locale: "en"}); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if err := s.Append(ctx, Entry{Timestamp: cutoff.Add(time.Hour), Name: "new", Locale: "en"}); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+
+	entries, err := s.List(ctx, Filter{Since: cutoff.Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2", len(entries))
+	}
+
+	if err := s.Purge(ctx, cutoff); err != nil {
+		t.Fatalf("Purge returned error: %v", err)
+	}
+
+	entries, err = s.List(ctx, Filter{Since: cutoff.Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("List after purge returned error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name != "new" {
+		t.Errorf("entries after purge = %+v, want only %q", entries, "new")
+	}
+}
+
+func TestNewSQLiteStoreIsIdempotentAcrossReopens(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history.db")
+
+	s1, err := NewSQLiteStore(ctx, path)
+	if err != nil {
+		t.Fatalf("first NewSQLiteStore returned error: %v", err)
+	}
+	s1.Close()
+
+	s2, err := NewSQLiteStore(ctx, path)
+	if err != nil {
+		t.Fatalf("second NewSQLiteStore returned error: %v", err)
+	}
+	defer s2.Close()
+}
@@ -0,0 +1,50 @@
+// Package history records and queries past greetings, with pluggable storage
+// backends (in-memory, SQLite, JSONL) behind a common Store interface.
+package history
+
+import (
+	"context"
+	"time"
+)
+
+// Entry is one recorded greeting.
+type Entry struct {
+	Timestamp time.Time
+	Name      string
+	Greeting  string
+	Locale    string
+	Latency   time.Duration
+	Error     string
+}
+
+// Filter narrows List results. A zero-value field means "no constraint" on
+// that dimension.
+type Filter struct {
+	Since  time.Time
+	Name   string
+	Locale string
+}
+
+// Store persists and queries greeting Entries.
+type Store interface {
+	// Append records a single entry.
+	Append(ctx context.Context, e Entry) error
+	// List returns entries matching filter, oldest first.
+	List(ctx context.Context, filter Filter) ([]Entry, error)
+	// Purge deletes entries older than before.
+	Purge(ctx context.Context, before time.Time) error
+}
+
+// matches reports whether e satisfies filter.
+func (f Filter) matches(e Entry) bool {
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+	if f.Name != "" && e.Name != f.Name {
+		return false
+	}
+	if f.Locale != "" && e.Locale != f.Locale {
+		return false
+	}
+	return true
+}
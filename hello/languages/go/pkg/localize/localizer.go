@@ -0,0 +1,60 @@
+package localize
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// LocalizedGreeter renders greetings for a single BCP-47 tag out of a
+// Catalog, using x/text's matcher to fall back through the tag's parent
+// chain (and finally to English) when no exact translation exists.
+type LocalizedGreeter struct {
+	tag     language.Tag
+	catalog *Catalog
+	printer *message.Printer
+}
+
+// NewLocalizedGreeter returns a Greeter that renders messages for tag out of catalog.
+//
+// catalog.builder's lookup only walks tag's own parent chain (es-MX -> es ->
+// ...), never its configured Fallback(language.English) unless English is
+// actually an ancestor of tag. So ko would never reach the English catalog
+// entries on its own. Resolving tag through the builder's matcher first
+// (which does consult the fallback) and building the printer with the
+// matched tag makes the English fallback apply to every locale, not just
+// ones descended from it.
+func NewLocalizedGreeter(tag language.Tag, catalog *Catalog) *LocalizedGreeter {
+	matched, _, _ := catalog.builder.Matcher().Match(tag)
+	return &LocalizedGreeter{
+		tag:     matched,
+		catalog: catalog,
+		printer: message.NewPrinter(matched, message.Catalog(catalog.builder)),
+	}
+}
+
+// Greet renders a single-name greeting in the configured locale.
+func (g *LocalizedGreeter) Greet(name string) string {
+	return g.printer.Sprintf(keyGreeting, name)
+}
+
+// GreetFormal renders a formal-register greeting when the locale defines
+// one, falling back to Greet otherwise.
+func (g *LocalizedGreeter) GreetFormal(name string) string {
+	if !g.catalog.SupportsFormal(g.tag) {
+		return g.Greet(name)
+	}
+	return g.printer.Sprintf(keyGreetingFormal, name)
+}
+
+// GreetMany renders a pluralized greeting for the first name plus a count of
+// the remaining names, e.g. "Hello, Alice and 3 others!".
+func (g *LocalizedGreeter) GreetMany(names []string) string {
+	switch len(names) {
+	case 0:
+		return g.Greet("")
+	case 1:
+		return g.Greet(names[0])
+	default:
+		return g.printer.Sprintf(keyGreetMany, names[0], len(names)-1)
+	}
+}
@@ -0,0 +1,113 @@
+package localize
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"golang.org/x/text/language"
+)
+
+func testCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	c, err := NewCatalog()
+	if err != nil {
+		t.Fatalf("NewCatalog() returned error: %v", err)
+	}
+	return c
+}
+
+func TestGreetRendersExactLocale(t *testing.T) {
+	c := testCatalog(t)
+	g := NewLocalizedGreeter(language.Spanish, c)
+
+	if want, got := "¡Hola, Ada!", g.Greet("Ada"); got != want {
+		t.Errorf("Greet() = %q, want %q", got, want)
+	}
+}
+
+func TestGreetFallsBackThroughParentChain(t *testing.T) {
+	c := testCatalog(t)
+	// es-MX has no locale file of its own, so it should fall back to es.
+	g := NewLocalizedGreeter(language.MustParse("es-MX"), c)
+
+	if want, got := "¡Hola, Ada!", g.Greet("Ada"); got != want {
+		t.Errorf("Greet() = %q, want %q", got, want)
+	}
+}
+
+func TestGreetFallsBackToEnglishWhenLocaleUnknown(t *testing.T) {
+	c := testCatalog(t)
+	g := NewLocalizedGreeter(language.MustParse("ko"), c)
+
+	if want, got := "Hello, Ada!", g.Greet("Ada"); got != want {
+		t.Errorf("Greet() = %q, want %q", got, want)
+	}
+}
+
+func TestGreetFormalFallsBackWhenUnsupported(t *testing.T) {
+	c := testCatalog(t)
+	// fr.json does not define a formal variant.
+	g := NewLocalizedGreeter(language.French, c)
+
+	if want, got := g.Greet("Ada"), g.GreetFormal("Ada"); got != want {
+		t.Errorf("GreetFormal() = %q, want fallback to Greet() = %q", got, want)
+	}
+}
+
+func TestGreetFormalUsesLocaleVariant(t *testing.T) {
+	c := testCatalog(t)
+	g := NewLocalizedGreeter(language.German, c)
+
+	if want, got := "Guten Tag, Ada!", g.GreetFormal("Ada"); got != want {
+		t.Errorf("GreetFormal() = %q, want %q", got, want)
+	}
+}
+
+func TestGreetManyPluralSelection(t *testing.T) {
+	c := testCatalog(t)
+	g := NewLocalizedGreeter(language.English, c)
+
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{[]string{"Alice"}, "Hello, Alice!"},
+		{[]string{"Alice", "Bob"}, "Hello, Alice and 1 other!"},
+		{[]string{"Alice", "Bob", "Carol", "Dan"}, "Hello, Alice and 3 others!"},
+	}
+	for _, tt := range tests {
+		if got := g.GreetMany(tt.names); got != tt.want {
+			t.Errorf("GreetMany(%v) = %q, want %q", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestReloadAcceptsLocaleFileWithoutOptionalKeys(t *testing.T) {
+	c := testCatalog(t)
+	minimalFS := fstest.MapFS{
+		"locales/en.json": &fstest.MapFile{Data: []byte(`{"greeting": "Hi, %[1]s!"}`)},
+	}
+
+	if err := c.Reload(minimalFS); err != nil {
+		t.Fatalf("Reload with a minimal locale file (missing greet_many keys) returned error: %v", err)
+	}
+
+	g := NewLocalizedGreeter(language.English, c)
+	if want, got := "Hi, Ada!", g.Greet("Ada"); got != want {
+		t.Errorf("Greet() after Reload = %q, want %q", got, want)
+	}
+	if g.GreetFormal("Ada") != g.Greet("Ada") {
+		t.Error("GreetFormal() should fall back to Greet() when greeting_formal is absent from the reloaded file")
+	}
+}
+
+func TestReloadRejectsInvalidLocaleTag(t *testing.T) {
+	c := testCatalog(t)
+	badFS := fstest.MapFS{
+		"locales/not-a-tag!!.json": &fstest.MapFile{Data: []byte(`{"greeting": "Hi, %[1]s!"}`)},
+	}
+
+	if err := c.Reload(badFS); err == nil {
+		t.Error("Reload with an invalid BCP-47 filename = nil error, want non-nil")
+	}
+}
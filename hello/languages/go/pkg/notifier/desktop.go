@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gen2brain/beeep"
+)
+
+// DesktopNotifier shows a native OS toast notification via beeep, which
+// supports Windows, macOS, and Linux (via notify-send/dbus).
+type DesktopNotifier struct {
+	// AppIcon is an optional path to an icon shown alongside the toast.
+	AppIcon string
+}
+
+// NewDesktopNotifier returns a Notifier that shows a desktop toast for each call.
+func NewDesktopNotifier() *DesktopNotifier {
+	return &DesktopNotifier{}
+}
+
+// Notify shows a desktop toast with the given title and body.
+func (d *DesktopNotifier) Notify(ctx context.Context, title, body string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("notify cancelled: %w", err)
+	}
+	if err := beeep.Notify(title, body, d.AppIcon); err != nil {
+		return fmt.Errorf("failed to show desktop notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Package notifier provides a small abstraction over "tell the user a
+// greeting happened" channels: desktop toasts and audible chimes.
+package notifier
+
+import "context"
+
+// Notifier delivers a single notification with a title and body.
+type Notifier interface {
+	Notify(ctx context.Context, title, body string) error
+}
+
+// SilentNotifier discards every notification. It is useful in tests and as
+// the default when notifications are disabled.
+type SilentNotifier struct{}
+
+// Notify implements Notifier by doing nothing.
+func (SilentNotifier) Notify(ctx context.Context, title, body string) error {
+	return nil
+}
+
+// MultiNotifier fans a notification out to every Notifier it wraps,
+// continuing past individual failures and returning the first error seen.
+type MultiNotifier struct {
+	notifiers []Notifier
+}
+
+// NewMultiNotifier returns a Notifier that delivers to all of ns.
+func NewMultiNotifier(ns ...Notifier) *MultiNotifier {
+	return &MultiNotifier{notifiers: ns}
+}
+
+// Notify delivers to every wrapped Notifier, collecting the first error but
+// still attempting the remaining notifiers.
+func (m *MultiNotifier) Notify(ctx context.Context, title, body string) error {
+	var firstErr error
+	for _, n := range m.notifiers {
+		if err := n.Notify(ctx, title, body); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,50 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeNotifier struct {
+	err   error
+	calls int
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, title, body string) error {
+	f.calls++
+	return f.err
+}
+
+func TestSilentNotifierIsNoop(t *testing.T) {
+	if err := (SilentNotifier{}).Notify(context.Background(), "title", "body"); err != nil {
+		t.Errorf("SilentNotifier.Notify returned %v, want nil", err)
+	}
+}
+
+func TestMultiNotifierCallsAll(t *testing.T) {
+	a := &fakeNotifier{}
+	b := &fakeNotifier{}
+	m := NewMultiNotifier(a, b)
+
+	if err := m.Notify(context.Background(), "title", "body"); err != nil {
+		t.Fatalf("Notify returned error: %v", err)
+	}
+	if a.calls != 1 || b.calls != 1 {
+		t.Errorf("calls = (%d, %d), want (1, 1)", a.calls, b.calls)
+	}
+}
+
+func TestMultiNotifierReturnsFirstErrorButCallsAll(t *testing.T) {
+	errA := errors.New("a failed")
+	a := &fakeNotifier{err: errA}
+	b := &fakeNotifier{}
+	m := NewMultiNotifier(a, b)
+
+	if err := m.Notify(context.Background(), "title", "body"); !errors.Is(err, errA) {
+		t.Errorf("Notify error = %v, want %v", err, errA)
+	}
+	if b.calls != 1 {
+		t.Errorf("b.calls = %d, want 1", b.calls)
+	}
+}
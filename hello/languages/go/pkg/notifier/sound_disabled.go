@@ -0,0 +1,28 @@
+//go:build !sound
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+)
+
+// SoundNotifier is a stub used when the module is built without the `sound`
+// build tag (the default). It returns an error on Notify instead of silently
+// doing nothing, so a misconfigured -sound flag is loud rather than quiet.
+type SoundNotifier struct {
+	// Path is a filesystem path to an MP3 file, or "builtin" to use the
+	// embedded default chime. Unused by this stub.
+	Path string
+}
+
+// NewSoundNotifier returns a SoundNotifier stub. Build with -tags sound to
+// get a Notifier that actually plays path.
+func NewSoundNotifier(path string) *SoundNotifier {
+	return &SoundNotifier{Path: path}
+}
+
+// Notify always fails: this binary was built without the `sound` build tag.
+func (s *SoundNotifier) Notify(ctx context.Context, title, body string) error {
+	return fmt.Errorf("sound notifications are unavailable: rebuild with -tags sound")
+}
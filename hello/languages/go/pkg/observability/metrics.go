@@ -0,0 +1,102 @@
+// Package observability provides decorators that make any Greeter emit
+// Prometheus metrics and OpenTelemetry traces, so they can compose around a
+// SimpleGreeter the same way notifier and localize decorate it today.
+package observability
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Greeter is the minimal interface decorators in this package wrap. It
+// mirrors the comprehensive main's Greeter so this package doesn't import
+// package main.
+type Greeter interface {
+	Greet(name string) string
+}
+
+// ContextGreeter is implemented by greeters that support context-aware,
+// cancellable greetings (GreetWithContext in the comprehensive main).
+type ContextGreeter interface {
+	GreetWithContext(ctx context.Context, name string) (string, error)
+}
+
+// MeteredGreeter wraps a Greeter, recording request counts, latency, and
+// in-flight concurrency to Prometheus.
+type MeteredGreeter struct {
+	next Greeter
+
+	requestsTotal  *prometheus.CounterVec
+	requestSeconds prometheus.Histogram
+	activeInflight prometheus.Gauge
+}
+
+// NewMeteredGreeter wraps next, registering its metrics on reg.
+func NewMeteredGreeter(next Greeter, reg prometheus.Registerer) *MeteredGreeter {
+	m := &MeteredGreeter{
+		next: next,
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "greeter_requests_total",
+			Help: "Total greeting requests, labeled by outcome status.",
+		}, []string{"status"}),
+		requestSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "greeter_request_duration_seconds",
+			Help:    "Greeting request latency in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		activeInflight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "greeter_active_inflight",
+			Help: "Number of greeting requests currently in flight.",
+		}),
+	}
+
+	reg.MustRegister(m.requestsTotal, m.requestSeconds, m.activeInflight)
+	return m
+}
+
+// Greet records metrics around a call to the wrapped Greeter.
+func (m *MeteredGreeter) Greet(name string) string {
+	m.activeInflight.Inc()
+	defer m.activeInflight.Dec()
+
+	start := time.Now()
+	message := m.next.Greet(name)
+	m.requestSeconds.Observe(time.Since(start).Seconds())
+	m.requestsTotal.WithLabelValues("ok").Inc()
+	return message
+}
+
+// GreetWithContext records metrics around a context-aware greeting when the
+// wrapped Greeter supports it, labeling the status "error" or "cancelled" as
+// appropriate.
+func (m *MeteredGreeter) GreetWithContext(ctx context.Context, name string) (string, error) {
+	cg, ok := m.next.(ContextGreeter)
+	if !ok {
+		return m.Greet(name), nil
+	}
+
+	m.activeInflight.Inc()
+	defer m.activeInflight.Dec()
+
+	start := time.Now()
+	message, err := cg.GreetWithContext(ctx, name)
+	m.requestSeconds.Observe(time.Since(start).Seconds())
+
+	status := "ok"
+	switch {
+	case err != nil && ctx.Err() != nil:
+		status = "cancelled"
+	case err != nil:
+		status = "error"
+	}
+	m.requestsTotal.WithLabelValues(status).Inc()
+	return message, err
+}
+
+// NewTestMeter returns an in-memory Prometheus registry suitable for
+// asserting on metrics in tests, without touching the default global registry.
+func NewTestMeter() *prometheus.Registry {
+	return prometheus.NewRegistry()
+}
@@ -0,0 +1,69 @@
+package observability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type staticGreeter struct{ message string }
+
+func (g staticGreeter) Greet(name string) string { return g.message }
+
+func TestMeteredGreeterRecordsRequestsTotal(t *testing.T) {
+	reg := NewTestMeter()
+	m := NewMeteredGreeter(staticGreeter{message: "Hello, Ada!"}, reg)
+
+	if got := m.Greet("Ada"); got != "Hello, Ada!" {
+		t.Errorf("Greet() = %q, want %q", got, "Hello, Ada!")
+	}
+
+	got, err := testutil.GatherAndCount(reg, "greeter_requests_total")
+	if err != nil {
+		t.Fatalf("GatherAndCount returned error: %v", err)
+	}
+	if got != 1 {
+		t.Errorf("greeter_requests_total series count = %d, want 1", got)
+	}
+}
+
+func TestMeteredGreeterTracksInflightAroundCall(t *testing.T) {
+	reg := NewTestMeter()
+	m := NewMeteredGreeter(staticGreeter{message: "Hello, Ada!"}, reg)
+
+	m.Greet("Ada")
+
+	metrics, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather returned error: %v", err)
+	}
+
+	for _, mf := range metrics {
+		if mf.GetName() != "greeter_active_inflight" {
+			continue
+		}
+		if got := mf.Metric[0].GetGauge().GetValue(); got != 0 {
+			t.Errorf("greeter_active_inflight after Greet returns = %v, want 0", got)
+		}
+	}
+}
+
+func TestGreetingPrefixExtractsLeadingSegment(t *testing.T) {
+	tests := map[string]string{
+		"Hello, Ada!": "Hello",
+		"no-comma":    "no-comma",
+	}
+	for message, want := range tests {
+		if got := greetingPrefix(message); got != want {
+			t.Errorf("greetingPrefix(%q) = %q, want %q", message, got, want)
+		}
+	}
+}
+
+func TestTracedGreeterPropagatesWrappedMessage(t *testing.T) {
+	tg := NewTracedGreeter(staticGreeter{message: "Hello, Ada!"})
+	if got := tg.Greet("Ada"); !strings.HasPrefix(got, "Hello") {
+		t.Errorf("Greet() = %q, want it to start with %q", got, "Hello")
+	}
+}
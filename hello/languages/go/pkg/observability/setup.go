@@ -0,0 +1,52 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ServeMetrics starts an HTTP server exposing the default Prometheus
+// registry's metrics at /metrics on addr. It returns once the listener is up
+// (so a failure to bind addr is reported to the caller), with serving itself
+// happening in a background goroutine, matching how the gRPC server in
+// cmd/greeter-server is started.
+func ServeMetrics(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{}))
+
+	go func() {
+		log.Printf("metrics server listening on %s", addr)
+		if err := http.Serve(lis, mux); err != nil {
+			log.Printf("metrics server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// ConfigureOTLPTracing points the global OpenTelemetry tracer provider at an
+// OTLP gRPC endpoint, returning a shutdown func that flushes pending spans.
+func ConfigureOTLPTracing(ctx context.Context, endpoint string) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := trace.NewTracerProvider(trace.WithBatcher(exporter))
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
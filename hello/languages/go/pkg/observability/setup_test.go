@@ -0,0 +1,24 @@
+package observability
+
+import (
+	"net"
+	"testing"
+)
+
+func TestServeMetricsReturnsErrorWhenAddrInUse(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a port: %v", err)
+	}
+	defer lis.Close()
+
+	if err := ServeMetrics(lis.Addr().String()); err == nil {
+		t.Error("ServeMetrics on an address already in use = nil error, want non-nil")
+	}
+}
+
+func TestServeMetricsBindsBeforeReturning(t *testing.T) {
+	if err := ServeMetrics("127.0.0.1:0"); err != nil {
+		t.Fatalf("ServeMetrics returned error: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies this package's spans in OpenTelemetry backends.
+const tracerName = "example.com/hello/languages/go/pkg/observability"
+
+// TracedGreeter wraps a Greeter, starting an OpenTelemetry span around each
+// Greet call and propagating context through GreetWithContext so the span
+// can be cancelled along with the request.
+type TracedGreeter struct {
+	next   Greeter
+	tracer trace.Tracer
+}
+
+// NewTracedGreeter wraps next, using the global OpenTelemetry tracer provider.
+func NewTracedGreeter(next Greeter) *TracedGreeter {
+	return &TracedGreeter{next: next, tracer: otel.Tracer(tracerName)}
+}
+
+// Greet starts a span with no parent context, since the plain Greeter
+// interface has nowhere to carry one.
+func (t *TracedGreeter) Greet(name string) string {
+	message, _ := t.GreetWithContext(context.Background(), name)
+	return message
+}
+
+// GreetWithContext starts a span under ctx, records name.length and
+// greeting.prefix attributes, and propagates ctx to the wrapped Greeter when
+// it supports GreetWithContext.
+func (t *TracedGreeter) GreetWithContext(ctx context.Context, name string) (string, error) {
+	ctx, span := t.tracer.Start(ctx, "Greeter.Greet")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("name.length", len(name)))
+
+	var message string
+	var err error
+	if cg, ok := t.next.(ContextGreeter); ok {
+		message, err = cg.GreetWithContext(ctx, name)
+	} else {
+		message = t.next.Greet(name)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return message, err
+	}
+
+	span.SetAttributes(attribute.String("greeting.prefix", greetingPrefix(message)))
+	return message, nil
+}
+
+// greetingPrefix best-effort derives a greeting's prefix by taking everything
+// before its first comma, so the span attribute is useful without requiring
+// every Greeter to expose its prefix separately.
+func greetingPrefix(message string) string {
+	for i, r := range message {
+		if r == ',' {
+			return message[:i]
+		}
+	}
+	return message
+}